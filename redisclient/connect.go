@@ -0,0 +1,72 @@
+package redisclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// defaultPingTimeout bounds the connect-time health check performed by
+// FromURLWithOptions when ConnectOptions.PingTimeout is unset.
+const defaultPingTimeout = 5 * time.Second
+
+// ConnectOptions controls the connect-time behavior of FromURLWithOptions.
+type ConnectOptions struct {
+	// PingTimeout bounds how long the initial connectivity check may take.
+	// Zero means defaultPingTimeout; it has no effect when SkipPing is set.
+	PingTimeout time.Duration
+	// SkipPing disables the connect-time health check, restoring the
+	// behavior of just constructing a client without confirming it can
+	// reach the server.
+	SkipPing bool
+	// Logger, if set, is called with a line reporting a successful
+	// connect-time health check. It is not called when the check fails
+	// (the failure is returned as an error) or when SkipPing is set.
+	Logger func(format string, args ...interface{})
+}
+
+// FromURLWithOptions is like FromURL but additionally lets the caller tune
+// the connect-time health check performed before the client is returned, so
+// a misconfigured endpoint is caught immediately instead of on the first
+// real command.
+func FromURLWithOptions(rawURL string, opts ConnectOptions) (redis.UniversalClient, error) {
+	if rawURL == "" {
+		return nil, fmt.Errorf("redis url must not be empty")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing redis url: %w", err)
+	}
+
+	client, err := clientFromURL(u, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SkipPing {
+		return client, nil
+	}
+
+	timeout := opts.PingTimeout
+	if timeout <= 0 {
+		timeout = defaultPingTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("connecting to redis %q: %w", u.Redacted(), err)
+	}
+
+	if opts.Logger != nil {
+		opts.Logger("connected to redis %q", u.Redacted())
+	}
+
+	return client, nil
+}
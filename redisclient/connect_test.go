@@ -0,0 +1,21 @@
+package redisclient
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromURLWithOptions_SkipPing(t *testing.T) {
+	client, err := FromURLWithOptions("redis://127.0.0.1:1", ConnectOptions{SkipPing: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+}
+
+func TestFromURLWithOptions_PingFailureReturnsError(t *testing.T) {
+	_, err := FromURLWithOptions("redis://127.0.0.1:1", ConnectOptions{PingTimeout: 200 * time.Millisecond})
+	if err == nil {
+		t.Fatal("expected an error pinging an unreachable redis server")
+	}
+}
@@ -0,0 +1,50 @@
+package redisclient
+
+import (
+	"os"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// envVars lists, in priority order, the environment variables FromEnv
+// checks by default. It follows the convention used by several hosted
+// Redis add-ons so the module drops into those PaaS environments without
+// any config plumbing.
+var envVars = []string{
+	"REDIS_URL",
+	"REDIS_TLS_URL",
+	"REDISCLOUD_URL",
+	"REDISTOGO_URL",
+	"OPENREDIS_URL",
+	"REDISGREEN_URL",
+}
+
+// defaultURL is used by FromEnv when none of the candidate environment
+// variables are set.
+const defaultURL = "redis://localhost:6379"
+
+// FromEnv builds a Redis client from the first non-empty environment
+// variable among names, trying them in order. When no names are given it
+// falls back to envVars, the set of variables populated by common hosted
+// Redis add-ons. If none of the candidates are set, it connects to
+// defaultURL.
+func FromEnv(names ...string) (redis.UniversalClient, error) {
+	return FromURL(candidateURL(names))
+}
+
+// candidateURL returns the first non-empty value among the named
+// environment variables, falling back to defaultURL when none are set or
+// names is empty.
+func candidateURL(names []string) string {
+	if len(names) == 0 {
+		names = envVars
+	}
+
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+
+	return defaultURL
+}
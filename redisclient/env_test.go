@@ -0,0 +1,37 @@
+package redisclient
+
+import "testing"
+
+func TestCandidateURL_FallsBackToDefault(t *testing.T) {
+	for _, name := range envVars {
+		t.Setenv(name, "")
+	}
+
+	if got := candidateURL(nil); got != defaultURL {
+		t.Fatalf("got %q, want %q", got, defaultURL)
+	}
+}
+
+func TestCandidateURL_PrefersFirstNonEmptyName(t *testing.T) {
+	t.Setenv("CUSTOM_REDIS_URL", "")
+	t.Setenv("FALLBACK_REDIS_URL", "redis://fallback:6379")
+
+	got := candidateURL([]string{"CUSTOM_REDIS_URL", "FALLBACK_REDIS_URL"})
+	want := "redis://fallback:6379"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestCandidateURL_UsesDefaultEnvVarsWhenNoneGiven(t *testing.T) {
+	for _, name := range envVars {
+		t.Setenv(name, "")
+	}
+	t.Setenv("REDISCLOUD_URL", "redis://cloud:6379")
+
+	got := candidateURL(nil)
+	want := "redis://cloud:6379"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package redisclient
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// applyQueryOptions reads connection-tuning parameters out of the URL query
+// string and applies the ones that were set to opts.
+func applyQueryOptions(opts *redis.UniversalOptions, query url.Values) error {
+	durationParams := []struct {
+		name string
+		dst  *time.Duration
+	}{
+		{"dial_timeout", &opts.DialTimeout},
+		{"read_timeout", &opts.ReadTimeout},
+		{"write_timeout", &opts.WriteTimeout},
+	}
+	for _, p := range durationParams {
+		v := query.Get(p.name)
+		if v == "" {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parsing %s %q: %w", p.name, v, err)
+		}
+		*p.dst = d
+	}
+
+	intParams := []struct {
+		name string
+		dst  *int
+	}{
+		{"pool_size", &opts.PoolSize},
+		{"min_idle_conns", &opts.MinIdleConns},
+		{"max_retries", &opts.MaxRetries},
+	}
+	for _, p := range intParams {
+		v := query.Get(p.name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parsing %s %q: %w", p.name, v, err)
+		}
+		*p.dst = n
+	}
+
+	boolParams := []struct {
+		name string
+		dst  *bool
+	}{
+		{"route_by_latency", &opts.RouteByLatency},
+		{"route_randomly", &opts.RouteRandomly},
+	}
+	for _, p := range boolParams {
+		v := query.Get(p.name)
+		if v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("parsing %s %q: %w", p.name, v, err)
+		}
+		*p.dst = b
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Package redisclient builds redis.UniversalClient instances from a single
+// connection URL, supporting standalone, Sentinel, and Cluster deployments
+// behind one uniform entrypoint.
+package redisclient
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// FromURL creates a new Redis client based on the provided URL.
+// The URL scheme can be `redis` or `rediss` for a standalone server,
+// `redis+sentinel` for a Sentinel-managed deployment, or `redis+cluster`
+// for a Redis Cluster deployment. All three return a redis.UniversalClient,
+// so callers get one uniform type regardless of topology.
+//
+// It is a thin wrapper around FromURLWithOptions using the default
+// ConnectOptions.
+func FromURL(rawURL string) (redis.UniversalClient, error) {
+	return FromURLWithOptions(rawURL, ConnectOptions{})
+}
+
+// clientFromURL builds the redis.UniversalClient matching u's scheme,
+// without performing any connectivity check.
+func clientFromURL(u *url.URL, rawURL string) (redis.UniversalClient, error) {
+	switch u.Scheme {
+	case "redis", "rediss":
+		return standaloneClientFromURL(u, rawURL, u.Scheme == "rediss")
+	case "redis+sentinel", "redis+cluster", "redis+sentinel+tls", "redis+cluster+tls":
+		scheme, useTLS := strings.CutSuffix(u.Scheme, "+tls")
+		return universalClientFromURL(u, scheme, useTLS)
+	default:
+		return nil, fmt.Errorf("unsupported redis url scheme %q", u.Scheme)
+	}
+}
+
+// standaloneClientFromURL builds a client from a plain redis:// or rediss://
+// URL via redis.ParseURL, which understands the timeout, pool_size,
+// min_idle_conns and max_retries parameters that applyQueryOptions applies
+// to sentinel/cluster URLs but rejects route_by_latency and route_randomly.
+// It wraps the result in a redis.UniversalClient so callers get the same
+// type regardless of topology. useTLS enables the tls_* query parameters; it is
+// only ever true for rediss:// URLs.
+func standaloneClientFromURL(u *url.URL, rawURL string, useTLS bool) (redis.UniversalClient, error) {
+	tlsConfig, err := tlsConfigFor(u, useTLS)
+	if err != nil {
+		return nil, err
+	}
+
+	stripped := stripTLSQueryParams(u)
+	redisOptions, err := redis.ParseURL(stripped.String())
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		redisOptions.TLSConfig = tlsConfig
+	}
+
+	return redis.NewUniversalClient(&redis.UniversalOptions{
+		Addrs:        []string{redisOptions.Addr},
+		DB:           redisOptions.DB,
+		Username:     redisOptions.Username,
+		Password:     redisOptions.Password,
+		MaxRetries:   redisOptions.MaxRetries,
+		DialTimeout:  redisOptions.DialTimeout,
+		ReadTimeout:  redisOptions.ReadTimeout,
+		WriteTimeout: redisOptions.WriteTimeout,
+		PoolSize:     redisOptions.PoolSize,
+		MinIdleConns: redisOptions.MinIdleConns,
+		TLSConfig:    redisOptions.TLSConfig,
+	}), nil
+}
+
+// universalClientFromURL builds a Sentinel- or Cluster-backed client from a
+// redis+sentinel:// or redis+cluster:// URL, either of which may list
+// several comma-separated hosts where a plain redis:// URL only has one.
+// scheme is u.Scheme with any "+tls" suffix already removed; useTLS enables
+// the tls_* query parameters and is only true when that suffix was present.
+//
+// It builds the failover redis.Client (via redis.NewFailoverClient) or the
+// redis.ClusterClient directly rather
+// than going through redis.NewUniversalClient, whose choice of client type
+// is keyed off len(Addrs) rather than the scheme: a single-seed
+// redis+cluster:// URL would otherwise silently fall through to a plain
+// standalone client instead of a ClusterClient.
+func universalClientFromURL(u *url.URL, scheme string, useTLS bool) (redis.UniversalClient, error) {
+	addrs, err := parseAddrs(u.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlsConfigFor(u, useTLS)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &redis.UniversalOptions{Addrs: addrs, TLSConfig: tlsConfig}
+	if u.User != nil {
+		opts.Username = u.User.Username()
+		opts.Password, _ = u.User.Password()
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch scheme {
+	case "redis+sentinel":
+		if len(segments) > 0 && segments[0] != "" {
+			opts.MasterName = segments[0]
+		}
+		if opts.MasterName == "" {
+			return nil, fmt.Errorf("redis+sentinel url must include a master name, e.g. redis+sentinel://host:26379/mymaster")
+		}
+		if len(segments) > 1 && segments[1] != "" {
+			db, err := strconv.Atoi(segments[1])
+			if err != nil {
+				return nil, fmt.Errorf("parsing redis db index %q: %w", segments[1], err)
+			}
+			opts.DB = db
+		}
+	case "redis+cluster":
+		if len(segments) > 0 && segments[0] != "" {
+			db, err := strconv.Atoi(segments[0])
+			if err != nil {
+				return nil, fmt.Errorf("parsing redis db index %q: %w", segments[0], err)
+			}
+			if db != 0 {
+				// Redis Cluster has no SELECT; UniversalOptions.DB is
+				// dropped entirely by Cluster(), so a non-zero index would
+				// otherwise be silently discarded.
+				return nil, fmt.Errorf("redis+cluster url must not specify a non-zero db index, got %d", db)
+			}
+		}
+	}
+
+	if err := applyQueryOptions(opts, u.Query()); err != nil {
+		return nil, err
+	}
+
+	switch scheme {
+	case "redis+sentinel":
+		return redis.NewFailoverClient(opts.Failover()), nil
+	case "redis+cluster":
+		return redis.NewClusterClient(opts.Cluster()), nil
+	default:
+		panic("unreachable: clientFromURL only dispatches known schemes here")
+	}
+}
+
+// parseAddrs splits the comma-separated host list found in redis+sentinel
+// and redis+cluster URLs into individual host:port addresses, sorted so
+// that equivalent URLs always produce the same client configuration
+// regardless of the order hosts were listed in.
+func parseAddrs(hostList string) ([]string, error) {
+	parts := strings.Split(hostList, ",")
+	addrs := make([]string, 0, len(parts))
+	for _, part := range parts {
+		addr := strings.TrimSpace(part)
+		if addr == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return nil, fmt.Errorf("parsing redis host %q: %w", addr, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("redis url must specify at least one host")
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
@@ -0,0 +1,83 @@
+package redisclient
+
+import (
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func TestFromURL_UnsupportedScheme(t *testing.T) {
+	if _, err := FromURL("mongodb://localhost:27017"); err == nil {
+		t.Fatal("expected an error for an unsupported scheme")
+	}
+}
+
+func TestFromURL_Empty(t *testing.T) {
+	if _, err := FromURL(""); err == nil {
+		t.Fatal("expected an error for an empty url")
+	}
+}
+
+func TestFromURL_SentinelRequiresMasterName(t *testing.T) {
+	if _, err := FromURL("redis+sentinel://host1:26379,host2:26379"); err == nil {
+		t.Fatal("expected an error when no master name is given")
+	}
+}
+
+func TestFromURLWithOptions_SingleSeedClusterURLBuildsClusterClient(t *testing.T) {
+	client, err := FromURLWithOptions("redis+cluster://host1:6379/0", ConnectOptions{SkipPing: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	if _, ok := client.(*redis.ClusterClient); !ok {
+		t.Fatalf("expected a *redis.ClusterClient for a single-seed redis+cluster url, got %T", client)
+	}
+}
+
+func TestFromURLWithOptions_ClusterURLRejectsNonZeroDB(t *testing.T) {
+	if _, err := FromURLWithOptions("redis+cluster://host1:6379,host2:6379/3", ConnectOptions{SkipPing: true}); err == nil {
+		t.Fatal("expected an error for a redis+cluster url with a non-zero db index")
+	}
+}
+
+func TestFromURLWithOptions_SentinelURLBuildsFailoverClient(t *testing.T) {
+	client, err := FromURLWithOptions("redis+sentinel://host1:26379,host2:26379/mymaster", ConnectOptions{SkipPing: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	if _, ok := client.(*redis.Client); !ok {
+		t.Fatalf("expected a *redis.Client (failover) for a redis+sentinel url, got %T", client)
+	}
+}
+
+func TestParseAddrs(t *testing.T) {
+	addrs, err := parseAddrs("host2:6379,host1:6379, host3:6379 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"host1:6379", "host2:6379", "host3:6379"}
+	if len(addrs) != len(want) {
+		t.Fatalf("got %v, want %v", addrs, want)
+	}
+	for i, addr := range addrs {
+		if addr != want[i] {
+			t.Fatalf("got %v, want %v", addrs, want)
+		}
+	}
+}
+
+func TestParseAddrs_InvalidHost(t *testing.T) {
+	if _, err := parseAddrs("not-a-host-port"); err == nil {
+		t.Fatal("expected an error for a host missing a port")
+	}
+}
+
+func TestParseAddrs_Empty(t *testing.T) {
+	if _, err := parseAddrs(""); err == nil {
+		t.Fatal("expected an error for an empty host list")
+	}
+}
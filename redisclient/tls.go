@@ -0,0 +1,100 @@
+package redisclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+// tlsQueryParams lists the query parameters this package understands as TLS
+// configuration. They are stripped from the URL before it is handed to
+// redis.ParseURL, which rejects parameters it doesn't recognize.
+var tlsQueryParams = []string{
+	"tls_ca_cert_file",
+	"tls_cert_file",
+	"tls_key_file",
+	"tls_server_name",
+	"tls_insecure_skip_verify",
+}
+
+// tlsConfigFor builds the *tls.Config for u's tls_* query parameters when
+// useTLS is set, returning a non-nil zero-value config if none of them were
+// given so TLS is still enabled with its defaults. It returns nil, nil when
+// useTLS is false.
+func tlsConfigFor(u *url.URL, useTLS bool) (*tls.Config, error) {
+	if !useTLS {
+		return nil, nil
+	}
+	tlsConfig, err := buildTLSConfig(u.Query())
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	return tlsConfig, nil
+}
+
+// buildTLSConfig turns the tls_* query parameters into a *tls.Config. It
+// returns a nil config, with no error, when none of them are set.
+func buildTLSConfig(query url.Values) (*tls.Config, error) {
+	caFile := query.Get("tls_ca_cert_file")
+	certFile := query.Get("tls_cert_file")
+	keyFile := query.Get("tls_key_file")
+	serverName := query.Get("tls_server_name")
+	insecure := query.Get("tls_insecure_skip_verify")
+
+	if caFile == "" && certFile == "" && keyFile == "" && serverName == "" && insecure == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{ServerName: serverName}
+
+	if insecure != "" {
+		skip, err := strconv.ParseBool(insecure)
+		if err != nil {
+			return nil, fmt.Errorf("parsing tls_insecure_skip_verify %q: %w", insecure, err)
+		}
+		tlsConfig.InsecureSkipVerify = skip
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading tls_ca_cert_file %q: %w", caFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in tls_ca_cert_file %q", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("tls_cert_file and tls_key_file must both be set")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading tls client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// stripTLSQueryParams returns a copy of u with tlsQueryParams removed from
+// its query string.
+func stripTLSQueryParams(u *url.URL) *url.URL {
+	query := u.Query()
+	for _, name := range tlsQueryParams {
+		query.Del(name)
+	}
+	stripped := *u
+	stripped.RawQuery = query.Encode()
+	return &stripped
+}
@@ -0,0 +1,62 @@
+package redisclient
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestTLSConfigFor_DisabledWithoutTLSSuffix(t *testing.T) {
+	u, err := url.Parse("redis+sentinel://host:26379/mymaster?tls_insecure_skip_verify=true")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tlsConfig, err := tlsConfigFor(u, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig != nil {
+		t.Fatalf("expected no TLS config for a non-TLS scheme even with tls_* params set, got %v", tlsConfig)
+	}
+}
+
+func TestTLSConfigFor_EnabledWithTLSSuffix(t *testing.T) {
+	u, err := url.Parse("redis+sentinel+tls://host:26379/mymaster")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tlsConfig, err := tlsConfigFor(u, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig == nil {
+		t.Fatal("expected a default TLS config when the +tls suffix is present, even with no tls_* params")
+	}
+}
+
+func TestTLSConfigFor_InvalidInsecureFlag(t *testing.T) {
+	u, err := url.Parse("rediss://host:6379?tls_insecure_skip_verify=not-a-bool")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := tlsConfigFor(u, true); err == nil {
+		t.Fatal("expected an error for an invalid tls_insecure_skip_verify value")
+	}
+}
+
+func TestStripTLSQueryParams(t *testing.T) {
+	u, err := url.Parse("rediss://host:6379?tls_server_name=example.com&db=1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stripped := stripTLSQueryParams(u)
+	if stripped.Query().Get("tls_server_name") != "" {
+		t.Fatalf("expected tls_server_name to be stripped, got %q", stripped.Query().Get("tls_server_name"))
+	}
+	if stripped.Query().Get("db") != "1" {
+		t.Fatalf("expected unrelated query params to survive, got %q", stripped.Query().Get("db"))
+	}
+}